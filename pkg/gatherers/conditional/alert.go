@@ -0,0 +1,38 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+)
+
+// AlertsLookupFunc resolves the labels of a currently firing alert by name. Conditional gathering
+// functions that are triggered by an alert (rather than taking an explicit namespace/pod) use it
+// to find out which namespace, pod, etc. the alert refers to. It is supplied by whatever wires up
+// the Gatherer, since alerts are read from the cluster's Thanos querier, not from a client this
+// package otherwise has access to.
+type AlertsLookupFunc func(ctx context.Context, alertName string) (map[string]string, error)
+
+// alertLabel looks up alertName via gatherer's AlertsLookupFunc and returns the value of label.
+func alertLabel(ctx context.Context, gatherer *Gatherer, alertName, label string) (string, error) {
+	if gatherer.alertsLookup == nil {
+		return "", fmt.Errorf("no alerts lookup configured for the conditional gatherer")
+	}
+	labels, err := gatherer.alertsLookup(ctx, alertName)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve alert %q: %w", alertName, err)
+	}
+	value, ok := labels[label]
+	if !ok {
+		return "", fmt.Errorf("alert %q has no %q label", alertName, label)
+	}
+	return value, nil
+}
+
+// tailLinesPtr returns a pointer to tailLines for use as corev1.PodLogOptions.TailLines, or nil
+// when tailLines <= 0 so the API server returns the whole log instead of zero lines.
+func tailLinesPtr(tailLines int64) *int64 {
+	if tailLines <= 0 {
+		return nil
+	}
+	return &tailLines
+}
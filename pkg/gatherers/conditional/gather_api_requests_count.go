@@ -0,0 +1,50 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherAPIRequestCountsParams defines parameters for api_request_counts gatherer
+type GatherAPIRequestCountsParams struct {
+	AlertName string `json:"alert_name"`
+}
+
+var apiRequestCountsGVR = schema.GroupVersionResource{Group: "apiserver.openshift.io", Version: "v1", Resource: "apirequestcounts"}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherAPIRequestCounts,
+		func() interface{} { return &GatherAPIRequestCountsParams{} },
+		gatherAPIRequestCounts,
+	)
+}
+
+// gatherAPIRequestCounts collects the apirequestcounts for the resource named in the "resource"
+// label of the alert named in params.AlertName.
+func gatherAPIRequestCounts(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherAPIRequestCountsParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherAPIRequestCounts)}
+	}
+
+	resource, err := alertLabel(ctx, gatherer, params.AlertName, "resource")
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	apiRequestCounts, err := gatherer.dynamicClient.Resource(apiRequestCountsGVR).Get(ctx, resource, metav1.GetOptions{})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return []record.Record{{
+		Name: fmt.Sprintf("conditional/api_request_counts_of_resource_from_alert/%s.json", resource),
+		Item: record.JSONMarshaller{Object: apiRequestCounts},
+	}}, nil
+}
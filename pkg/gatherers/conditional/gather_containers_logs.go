@@ -0,0 +1,71 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherContainersLogsParams defines parameters for container_logs gatherer
+type GatherContainersLogsParams struct {
+	AlertName string `json:"alert_name"`
+	Namespace string `json:"namespace,omitempty"`
+	Container string `json:"container,omitempty"`
+	TailLines int64  `json:"tail_lines"`
+	Previous  bool   `json:"previous,omitempty"`
+}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherContainersLogs,
+		func() interface{} { return &GatherContainersLogsParams{} },
+		gatherContainersLogs,
+	)
+}
+
+// gatherContainersLogs collects the logs of the pod named by the alert referenced in
+// params.AlertName. params.Namespace and params.Container are used when set; only when they are
+// empty are the namespace and container read from the alert's labels.
+func gatherContainersLogs(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherContainersLogsParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherContainersLogs)}
+	}
+
+	namespace := params.Namespace
+	if namespace == "" {
+		var err error
+		namespace, err = alertLabel(ctx, gatherer, params.AlertName, "namespace")
+		if err != nil {
+			return nil, []error{err}
+		}
+	}
+	pod, err := alertLabel(ctx, gatherer, params.AlertName, "pod")
+	if err != nil {
+		return nil, []error{err}
+	}
+	container := params.Container
+	if container == "" {
+		container, err = alertLabel(ctx, gatherer, params.AlertName, "container")
+		if err != nil {
+			return nil, []error{err}
+		}
+	}
+
+	logs, err := gatherer.coreClient.Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: tailLinesPtr(params.TailLines),
+		Previous:  params.Previous,
+	}).DoRaw(ctx)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return []record.Record{{
+		Name: fmt.Sprintf("conditional/containers_logs/%s/%s/%s.log", namespace, pod, container),
+		Item: record.JSONMarshaller{Object: string(logs)},
+	}}, nil
+}
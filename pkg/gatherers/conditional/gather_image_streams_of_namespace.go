@@ -0,0 +1,50 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherImageStreamsOfNamespaceParams defines parameters for image streams of namespace gatherer
+type GatherImageStreamsOfNamespaceParams struct {
+	// Namespace from which to collect image streams
+	Namespace string `json:"namespace"`
+}
+
+var imageStreamsGVR = schema.GroupVersionResource{Group: "image.openshift.io", Version: "v1", Resource: "imagestreams"}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherImageStreamsOfNamespace,
+		func() interface{} { return &GatherImageStreamsOfNamespaceParams{} },
+		gatherImageStreamsOfNamespace,
+	)
+}
+
+// gatherImageStreamsOfNamespace collects the image streams defined in params.Namespace.
+func gatherImageStreamsOfNamespace(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherImageStreamsOfNamespaceParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherImageStreamsOfNamespace)}
+	}
+
+	imageStreams, err := gatherer.dynamicClient.Resource(imageStreamsGVR).Namespace(params.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	records := make([]record.Record, 0, len(imageStreams.Items))
+	for i := range imageStreams.Items {
+		imageStream := &imageStreams.Items[i]
+		records = append(records, record.Record{
+			Name: fmt.Sprintf("conditional/image_streams_of_namespace/%s/%s.json", params.Namespace, imageStream.GetName()),
+			Item: record.JSONMarshaller{Object: imageStream},
+		})
+	}
+	return records, nil
+}
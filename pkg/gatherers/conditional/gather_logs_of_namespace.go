@@ -0,0 +1,63 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherLogsOfNamespaceParams defines parameters for logs of namespace gatherer
+type GatherLogsOfNamespaceParams struct {
+	// Namespace from which to collect logs
+	Namespace string `json:"namespace"`
+	// A number of log lines to keep for each container
+	TailLines int64 `json:"tail_lines"`
+}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherLogsOfNamespace,
+		func() interface{} { return &GatherLogsOfNamespaceParams{} },
+		gatherLogsOfNamespace,
+	)
+}
+
+// gatherLogsOfNamespace collects the last params.TailLines lines of logs for every container of
+// every pod in params.Namespace.
+func gatherLogsOfNamespace(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherLogsOfNamespaceParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherLogsOfNamespace)}
+	}
+
+	pods, err := gatherer.coreClient.Pods(params.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var records []record.Record
+	var errs []error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, container := range pod.Spec.Containers {
+			logReq := gatherer.coreClient.Pods(params.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: container.Name,
+				TailLines: tailLinesPtr(params.TailLines),
+			})
+			logs, err := logReq.DoRaw(ctx)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			records = append(records, record.Record{
+				Name: fmt.Sprintf("conditional/logs_of_namespace/%s/%s/%s.log", params.Namespace, pod.Name, container.Name),
+				Item: record.JSONMarshaller{Object: string(logs)},
+			})
+		}
+	}
+	return records, errs
+}
@@ -0,0 +1,108 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherNamespaceEventsParams defines parameters for the namespace_events_from_alert gatherer
+type GatherNamespaceEventsParams struct {
+	AlertName    string `json:"alert_name"`
+	SinceSeconds int64  `json:"since_seconds"`
+	MaxEvents    int    `json:"max_events"`
+}
+
+var eventsGVR = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherNamespaceEvents,
+		func() interface{} { return &GatherNamespaceEventsParams{} },
+		gatherNamespaceEvents,
+	)
+}
+
+// gatherNamespaceEvents collects, for the namespace referenced by the alert named in
+// params.AlertName, the params.MaxEvents most recent Events whose lastTimestamp falls within the
+// last params.SinceSeconds. When an alert fires, the firing pod's events are often the single most
+// informative artifact after logs, so this complements the existing logs/pod-definition gatherers.
+func gatherNamespaceEvents(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherNamespaceEventsParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherNamespaceEvents)}
+	}
+
+	namespace, err := alertLabel(ctx, gatherer, params.AlertName, "namespace")
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	eventList, err := gatherer.dynamicClient.Resource(eventsGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	since := time.Now().Add(-time.Duration(params.SinceSeconds) * time.Second)
+	var events []eventSummary
+	for i := range eventList.Items {
+		event := &eventList.Items[i]
+		lastTimestamp, found, err := unstructured.NestedString(event.Object, "lastTimestamp")
+		if err != nil || !found {
+			continue
+		}
+		lastSeen, err := time.Parse(time.RFC3339, lastTimestamp)
+		if err != nil || lastSeen.Before(since) {
+			continue
+		}
+		involvedObjectName, _, _ := unstructured.NestedString(event.Object, "involvedObject", "name")
+		message, _, _ := unstructured.NestedString(event.Object, "message")
+		events = append(events, eventSummary{
+			Name:               event.GetName(),
+			LastSeen:           lastSeen,
+			InvolvedObjectName: gatherer.anonymize(involvedObjectName),
+			Message:            gatherer.anonymize(message),
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].LastSeen.After(events[j].LastSeen) })
+	// As with tailLinesPtr, MaxEvents <= 0 means "no cap" rather than "cap to zero", so a rule that
+	// doesn't set max_events still collects something instead of silently gathering nothing.
+	if params.MaxEvents > 0 && len(events) > params.MaxEvents {
+		events = events[:params.MaxEvents]
+	}
+
+	records := make([]record.Record, 0, len(events))
+	for _, event := range events {
+		records = append(records, record.Record{
+			Name: fmt.Sprintf("conditional/namespace_events/%s/%s.json", namespace, event.Name),
+			Item: record.JSONMarshaller{Object: event},
+		})
+	}
+	return records, nil
+}
+
+// eventSummary is the anonymized subset of an Event record.Record writes out for
+// namespace_events_from_alert.
+type eventSummary struct {
+	Name               string    `json:"name"`
+	LastSeen           time.Time `json:"last_seen"`
+	InvolvedObjectName string    `json:"involved_object_name"`
+	Message            string    `json:"message"`
+}
+
+// anonymize scrubs s through the configured AnonymizeFunc, or returns it unchanged if none was
+// configured.
+func (g *Gatherer) anonymize(s string) string {
+	if g.anonymizer == nil {
+		return s
+	}
+	return g.anonymizer(s)
+}
@@ -0,0 +1,88 @@
+package conditional
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newTestEvent(name, involvedObjectName, message string, lastSeen time.Time) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "test-namespace",
+			},
+			"lastTimestamp": lastSeen.Format(time.RFC3339),
+			"involvedObject": map[string]interface{}{
+				"name": involvedObjectName,
+			},
+			"message": message,
+		},
+	}
+}
+
+func TestGatherNamespaceEvents(t *testing.T) {
+	now := time.Now()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newTestEvent("recent-1", "pod-a", "recent message 1", now.Add(-1*time.Minute)),
+		newTestEvent("recent-2", "pod-b", "recent message 2", now.Add(-2*time.Minute)),
+		newTestEvent("stale", "pod-c", "stale message", now.Add(-1*time.Hour)),
+	)
+
+	gatherer := &Gatherer{
+		dynamicClient: client,
+		alertsLookup: func(ctx context.Context, alertName string) (map[string]string, error) {
+			return map[string]string{"namespace": "test-namespace"}, nil
+		},
+	}
+
+	params := GatherNamespaceEventsParams{
+		AlertName:    "SomeAlertFiring",
+		SinceSeconds: 600,
+		MaxEvents:    1,
+	}
+
+	records, errs := gatherNamespaceEvents(context.Background(), gatherer, params)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %#v", errs)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected exactly 1 record (max_events=1, stale event excluded), got %d", len(records))
+	}
+	if records[0].Name != "conditional/namespace_events/test-namespace/recent-1.json" {
+		t.Fatalf("expected the newest matching event first, got %s", records[0].Name)
+	}
+}
+
+func TestGatherNamespaceEventsUnsetMaxEventsIsUncapped(t *testing.T) {
+	now := time.Now()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newTestEvent("recent-1", "pod-a", "recent message 1", now.Add(-1*time.Minute)),
+		newTestEvent("recent-2", "pod-b", "recent message 2", now.Add(-2*time.Minute)),
+	)
+
+	gatherer := &Gatherer{
+		dynamicClient: client,
+		alertsLookup: func(ctx context.Context, alertName string) (map[string]string, error) {
+			return map[string]string{"namespace": "test-namespace"}, nil
+		},
+	}
+
+	records, errs := gatherNamespaceEvents(context.Background(), gatherer, GatherNamespaceEventsParams{
+		AlertName:    "SomeAlertFiring",
+		SinceSeconds: 600,
+	})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %#v", errs)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected an unset max_events to leave both matching events uncapped, got %d", len(records))
+	}
+}
@@ -0,0 +1,51 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GatherPodDefinitionParams defines parameters for pod_definition gatherer
+type GatherPodDefinitionParams struct {
+	AlertName string `json:"alert_name"`
+}
+
+func init() {
+	RegisterGatheringFunction(
+		GatherPodDefinition,
+		func() interface{} { return &GatherPodDefinitionParams{} },
+		gatherPodDefinition,
+	)
+}
+
+// gatherPodDefinition collects the definition of the pod named by the alert referenced in
+// params.AlertName.
+func gatherPodDefinition(ctx context.Context, gatherer *Gatherer, paramsInterface interface{}) ([]record.Record, []error) {
+	params, ok := paramsInterface.(GatherPodDefinitionParams)
+	if !ok {
+		return nil, []error{fmt.Errorf("invalid params type %T for %s", paramsInterface, GatherPodDefinition)}
+	}
+
+	namespace, err := alertLabel(ctx, gatherer, params.AlertName, "namespace")
+	if err != nil {
+		return nil, []error{err}
+	}
+	podName, err := alertLabel(ctx, gatherer, params.AlertName, "pod")
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	pod, err := gatherer.coreClient.Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return []record.Record{{
+		Name: fmt.Sprintf("conditional/pod_definition/%s/%s.json", namespace, podName),
+		Item: record.JSONMarshaller{Object: pod},
+	}}, nil
+}
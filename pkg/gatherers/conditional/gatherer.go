@@ -0,0 +1,53 @@
+package conditional
+
+import (
+	"context"
+
+	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// Gatherer holds the clients conditional gathering functions need to talk to the cluster. It is
+// constructed once per gathering run and passed to every registered GathererFunc.
+type Gatherer struct {
+	gatherKubeConfig *rest.Config
+	dynamicClient    dynamic.Interface
+	coreClient       corev1client.CoreV1Interface
+	alertsLookup     AlertsLookupFunc
+	anonymizer       AnonymizeFunc
+}
+
+// AnonymizeFunc scrubs cluster-identifying data (hostnames, IPs, etc.) out of a free-form string
+// before it's written to a record, the same way the rest of the gatherers anonymize their output.
+type AnonymizeFunc func(string) string
+
+// NewGatherer creates a Gatherer from the kubeconfig used for conditional gathering.
+func NewGatherer(
+	gatherKubeConfig *rest.Config,
+	dynamicClient dynamic.Interface,
+	coreClient corev1client.CoreV1Interface,
+	alertsLookup AlertsLookupFunc,
+	anonymizer AnonymizeFunc,
+) *Gatherer {
+	return &Gatherer{
+		gatherKubeConfig: gatherKubeConfig,
+		dynamicClient:    dynamicClient,
+		coreClient:       coreClient,
+		alertsLookup:     alertsLookup,
+		anonymizer:       anonymizer,
+	}
+}
+
+// GatherConditionally dispatches to the gathering function registered for name, looking it up in
+// the registry rather than switching on name directly so that out-of-tree packages can plug in
+// their own conditional gathering functions.
+func (g *Gatherer) GatherConditionally(ctx context.Context, name GatheringFunctionName, params interface{}) ([]record.Record, []error) {
+	gatherer, err := Lookup(name)
+	if err != nil {
+		return nil, []error{err}
+	}
+	return gatherer(ctx, g, params)
+}
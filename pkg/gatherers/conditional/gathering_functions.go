@@ -1,10 +1,5 @@
 package conditional
 
-import (
-	"encoding/json"
-	"fmt"
-)
-
 // GatheringFunctions is a type to map gathering function name to its params
 type GatheringFunctions = map[GatheringFunctionName]interface{}
 
@@ -34,65 +29,14 @@ const (
 	// GatherPodDefinition is a function that collects the pod definitions
 	// See file gather_pod_definition.go
 	GatherPodDefinition GatheringFunctionName = "pod_definition"
-)
-
-func (name GatheringFunctionName) NewParams(jsonParams []byte) (interface{}, error) {
-	switch name {
-	case GatherLogsOfNamespace:
-		var result GatherLogsOfNamespaceParams
-		err := json.Unmarshal(jsonParams, &result)
-		return result, err
-	case GatherImageStreamsOfNamespace:
-		var result GatherImageStreamsOfNamespaceParams
-		err := json.Unmarshal(jsonParams, &result)
-		return result, err
-	case GatherAPIRequestCounts:
-		var params GatherAPIRequestCountsParams
-		err := json.Unmarshal(jsonParams, &params)
-		return params, err
-	case GatherContainersLogs:
-		var params GatherContainersLogsParams
-		err := json.Unmarshal(jsonParams, &params)
-		return params, err
-	case GatherPodDefinition:
-		var params GatherPodDefinitionParams
-		err := json.Unmarshal(jsonParams, &params)
-		return params, err
-	}
-	return nil, fmt.Errorf("unable to create params for %T: %v", name, name)
-}
-
-// params:
 
-// GatherLogsOfNamespaceParams defines parameters for logs of namespace gatherer
-type GatherLogsOfNamespaceParams struct {
-	// Namespace from which to collect logs
-	Namespace string `json:"namespace"`
-	// A number of log lines to keep for each container
-	TailLines int64 `json:"tail_lines"`
-}
-
-// GatherImageStreamsOfNamespaceParams defines parameters for image streams of namespace gatherer
-type GatherImageStreamsOfNamespaceParams struct {
-	// Namespace from which to collect image streams
-	Namespace string `json:"namespace"`
-}
-
-// GatherAPIRequestCountsParams defines parameters for api_request_counts gatherer
-type GatherAPIRequestCountsParams struct {
-	AlertName string `json:"alert_name"`
-}
-
-// GatherContainersLogsParams defines parameters for container_logs gatherer
-type GatherContainersLogsParams struct {
-	AlertName string `json:"alert_name"`
-	Namespace string `json:"namespace,omitempty"`
-	Container string `json:"container,omitempty"`
-	TailLines int64  `json:"tail_lines"`
-	Previous  bool   `json:"previous,omitempty"`
-}
+	// GatherNamespaceEvents is a function that collects the events of the namespace referenced by
+	// an alert.
+	// See file gather_namespace_events.go
+	GatherNamespaceEvents GatheringFunctionName = "namespace_events_from_alert"
+)
 
-// GatherPodDefinitionParams defines parameters for pod_definition gatherer
-type GatherPodDefinitionParams struct {
-	AlertName string `json:"alert_name"`
-}
+// NewParams and the dispatch to each gathering function are no longer handled here: each built-in
+// gathering function registers its params type and implementation with RegisterGatheringFunction
+// from its own file's init(), and GatheringFunctionName.NewParams (registry.go) consults that
+// registry. This keeps adding a new conditional gathering function from requiring an edit here.
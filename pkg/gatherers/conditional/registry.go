@@ -0,0 +1,83 @@
+package conditional
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+// GathererFunc is the signature a conditional gathering function must implement in order to be
+// usable by the conditional gatherer dispatch. It receives the already-unmarshaled params value
+// that was registered for its GatheringFunctionName.
+type GathererFunc func(ctx context.Context, gatherer *Gatherer, params interface{}) ([]record.Record, []error)
+
+// gatheringFunctionEntry bundles everything the registry needs to unmarshal params for, and
+// dispatch to, a single registered conditional gathering function.
+type gatheringFunctionEntry struct {
+	paramsFactory func() interface{}
+	gatherer      GathererFunc
+}
+
+// gatheringFunctions holds all the conditional gathering functions known to this process. Built-in
+// gathering functions register themselves via init() in their own file; out-of-tree packages can
+// call RegisterGatheringFunction from their own init() as well.
+var gatheringFunctions = make(map[GatheringFunctionName]gatheringFunctionEntry)
+
+// ErrGatheringFunctionNotRegistered is returned by NewParams and Lookup when asked about a
+// GatheringFunctionName that no package has registered.
+var ErrGatheringFunctionNotRegistered = fmt.Errorf("gathering function not registered")
+
+// RegisterGatheringFunction registers a conditional gathering function under name, so that it can
+// be looked up by the conditional gatherer dispatch without the registry needing to know about it
+// at compile time. paramsFactory must return a new, empty pointer to the params type for name;
+// it is called once per NewParams invocation. RegisterGatheringFunction is intended to be called
+// from an init() function and panics if name is already registered.
+func RegisterGatheringFunction(name GatheringFunctionName, paramsFactory func() interface{}, gatherer GathererFunc) {
+	if _, ok := gatheringFunctions[name]; ok {
+		panic(fmt.Sprintf("conditional gathering function %q is already registered", name))
+	}
+	gatheringFunctions[name] = gatheringFunctionEntry{
+		paramsFactory: paramsFactory,
+		gatherer:      gatherer,
+	}
+}
+
+// NewParams unmarshals jsonParams into the params type registered for name and returns it. It
+// returns ErrGatheringFunctionNotRegistered if name has no registered gathering function.
+func (name GatheringFunctionName) NewParams(jsonParams []byte) (interface{}, error) {
+	entry, ok := gatheringFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("unable to create params for %q: %w", name, ErrGatheringFunctionNotRegistered)
+	}
+	params := entry.paramsFactory()
+	if err := json.Unmarshal(jsonParams, params); err != nil {
+		return nil, err
+	}
+	// paramsFactory hands us a pointer to unmarshal into; dereference so callers keep getting
+	// the same value-type params they always have.
+	return reflect.ValueOf(params).Elem().Interface(), nil
+}
+
+// Lookup returns the gathering function registered for name, or ErrGatheringFunctionNotRegistered
+// if none is registered.
+func Lookup(name GatheringFunctionName) (GathererFunc, error) {
+	entry, ok := gatheringFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("unable to find gathering function %q: %w", name, ErrGatheringFunctionNotRegistered)
+	}
+	return entry.gatherer, nil
+}
+
+// List returns the names of all registered conditional gathering functions, sorted alphabetically.
+func List() []GatheringFunctionName {
+	names := make([]GatheringFunctionName, 0, len(gatheringFunctions))
+	for name := range gatheringFunctions {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
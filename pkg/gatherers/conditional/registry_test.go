@@ -0,0 +1,74 @@
+package conditional
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openshift/insights-operator/pkg/record"
+)
+
+func TestNewParamsUnregisteredName(t *testing.T) {
+	_, err := GatheringFunctionName("does_not_exist").NewParams([]byte(`{}`))
+	if !errors.Is(err, ErrGatheringFunctionNotRegistered) {
+		t.Fatalf("expected ErrGatheringFunctionNotRegistered, got %v", err)
+	}
+}
+
+func TestLookupUnregisteredName(t *testing.T) {
+	_, err := Lookup("does_not_exist")
+	if !errors.Is(err, ErrGatheringFunctionNotRegistered) {
+		t.Fatalf("expected ErrGatheringFunctionNotRegistered, got %v", err)
+	}
+}
+
+func TestRegisterGatheringFunctionPanicsOnDuplicate(t *testing.T) {
+	const name GatheringFunctionName = "test_duplicate_registration"
+	gatherer := func(context.Context, *Gatherer, interface{}) ([]record.Record, []error) { return nil, nil }
+
+	RegisterGatheringFunction(name, func() interface{} { return &struct{}{} }, gatherer)
+	defer delete(gatheringFunctions, name)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected RegisterGatheringFunction to panic on duplicate registration")
+		}
+	}()
+	RegisterGatheringFunction(name, func() interface{} { return &struct{}{} }, gatherer)
+}
+
+func TestNewParamsUnmarshalsRegisteredType(t *testing.T) {
+	params, err := GatherLogsOfNamespace.NewParams([]byte(`{"namespace":"openshift-etcd","tail_lines":20}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, ok := params.(GatherLogsOfNamespaceParams)
+	if !ok {
+		t.Fatalf("unexpected params type %T", params)
+	}
+	if got.Namespace != "openshift-etcd" || got.TailLines != 20 {
+		t.Fatalf("unexpected params %+v", got)
+	}
+}
+
+func TestListIncludesBuiltins(t *testing.T) {
+	names := List()
+	want := map[GatheringFunctionName]bool{
+		GatherLogsOfNamespace:         false,
+		GatherImageStreamsOfNamespace: false,
+		GatherAPIRequestCounts:        false,
+		GatherContainersLogs:          false,
+		GatherPodDefinition:           false,
+		GatherNamespaceEvents:         false,
+	}
+	for _, name := range names {
+		if _, ok := want[name]; ok {
+			want[name] = true
+		}
+	}
+	for name, found := range want {
+		if !found {
+			t.Errorf("expected %q to be registered", name)
+		}
+	}
+}
@@ -26,6 +26,8 @@ import (
 
 	"k8s.io/klog/v2"
 
+	"github.com/google/uuid"
+
 	configv1 "github.com/openshift/api/config/v1"
 	configv1client "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -49,8 +51,21 @@ type Client struct {
 	authorizer       Authorizer
 	gatherKubeConfig *rest.Config
 	clusterVersion   *configv1.ClusterVersion
+
+	// sink, when set via SetSink, receives archives instead of (or, if sinkAlsoSendToGateway,
+	// alongside) the gateway POST Send otherwise performs. sinkName labels the "sink" dimension of
+	// insightsclient_request_send_total.
+	sink                  Sink
+	sinkName              string
+	sinkAlsoSendToGateway bool
+
+	// retryConfig controls retries of the gateway POST; its zero value disables retries and
+	// keeps Send's original one-shot, streamed-upload behavior.
+	retryConfig RetryConfig
 }
 
+const gatewaySinkName = "gateway"
+
 type Authorizer interface {
 	Authorize(req *http.Request) error
 	NewSystemOrConfiguredProxy() func(*http.Request) (*url.URL, error)
@@ -198,8 +213,14 @@ func (c Client) prepareRequest(ctx context.Context, method string, endpoint stri
 	return req, nil
 }
 
-// Send uploads archives to Ingress service
+// Send uploads archives to the Ingress gateway. If a Sink has been configured via SetSink, it
+// uploads there instead of the gateway, or in addition to it when alsoSendToGateway was true when
+// the sink was set.
 func (c *Client) Send(ctx context.Context, endpoint string, source Source) error {
+	if c.sink != nil && !c.sinkAlsoSendToGateway {
+		return c.sendToSink(ctx, source)
+	}
+
 	cv, err := c.getClusterVersion()
 	if err != nil {
 		return err
@@ -208,10 +229,178 @@ func (c *Client) Send(ctx context.Context, endpoint string, source Source) error
 		return ErrWaitingForVersion
 	}
 
-	req, err := c.prepareRequest(ctx, http.MethodPost, endpoint, cv)
+	if c.sink != nil {
+		return c.sendToGatewayAndSink(ctx, endpoint, source, cv)
+	}
+
+	return c.sendToGatewayWithRetry(ctx, endpoint, source, cv)
+}
+
+// sendToSink uploads source to c.sink alone, bounding its size the same way the gateway path does
+// via LimitedReadCloser.
+func (c *Client) sendToSink(ctx context.Context, source Source) error {
+	source.Contents = &LimitedReadCloser{R: source.Contents, N: c.maxBytes}
+	requestID, err := c.sink.Upload(ctx, source)
+	statusLabel := strconv.Itoa(http.StatusOK)
+	if err != nil {
+		statusLabel = "0"
+	}
+	counterRequestSend.WithLabelValues(c.metricsName, statusLabel, c.sinkName).Inc()
+	if err != nil {
+		return err
+	}
+	if len(requestID) > 0 {
+		klog.V(2).Infof("Successfully reported id=%s, sink request id=%s", source.ID, requestID)
+	}
+	return nil
+}
+
+// sendToGatewayAndSink uploads source to both the gateway and c.sink, since source.Contents can
+// only be read once, it's first buffered to a bounded temp file so each destination gets its own
+// reader. Both uploads are attempted even if one fails; their errors are joined.
+func (c *Client) sendToGatewayAndSink(ctx context.Context, endpoint string, source Source, cv *configv1.ClusterVersion) error {
+	open, cleanup, err := c.bufferToTempFile(source.Contents)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	gatewayContents, err := open()
+	if err != nil {
+		return err
+	}
+	gatewaySource := source
+	gatewaySource.Contents = gatewayContents
+	gatewayErr := c.sendToGatewayWithRetry(ctx, endpoint, gatewaySource, cv)
+
+	sinkContents, err := open()
+	if err != nil {
+		return errors.Join(gatewayErr, err)
+	}
+	sinkSource := source
+	sinkSource.Contents = sinkContents
+	sinkErr := c.sendToSink(ctx, sinkSource)
+
+	return errors.Join(gatewayErr, sinkErr)
+}
+
+// sendToGatewayWithRetry runs sendToGatewayOnce, retrying on transient failures per
+// c.retryConfig. When retries are enabled (MaxAttempts > 1), source.Contents is buffered to a
+// bounded temp file up front, since it otherwise streams through an io.Pipe and cannot be
+// rewound for a second attempt. The idempotency key sent with every attempt is stable across
+// retries so the gateway can dedupe.
+func (c *Client) sendToGatewayWithRetry(ctx context.Context, endpoint string, source Source, cv *configv1.ClusterVersion) error {
+	retryConfig := c.retryConfig.orDefault()
+	idempotencyKey := uuid.New().String()
+
+	openContents, cleanup, err := c.attemptContentsOpener(source, retryConfig.MaxAttempts)
 	if err != nil {
 		return err
 	}
+	defer cleanup()
+
+	var lastErr error
+	for attempt := 1; attempt <= retryConfig.MaxAttempts; attempt++ {
+		contents, err := openContents()
+		if err != nil {
+			return err
+		}
+		attemptSource := source
+		attemptSource.Contents = contents
+
+		start := time.Now()
+		statusCode, retryAfter, err := c.sendToGatewayOnce(ctx, endpoint, attemptSource, cv, idempotencyKey)
+		if closeErr := contents.Close(); closeErr != nil {
+			klog.Warningf("Failed to close archive contents for attempt %d: %v", attempt, closeErr)
+		}
+		histogramRequestSendAttempt.WithLabelValues(c.metricsName, strconv.Itoa(statusCode), strconv.Itoa(attempt)).Observe(time.Since(start).Seconds())
+
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if attempt == retryConfig.MaxAttempts {
+			if retryConfig.MaxAttempts > 1 {
+				counterRequestSendRetriesExhausted.WithLabelValues(c.metricsName).Inc()
+			}
+			break
+		}
+		if !isRetryableSend(statusCode, err) {
+			break
+		}
+		klog.V(2).Infof("Retrying Insights archive upload (attempt %d/%d) after error: %v", attempt, retryConfig.MaxAttempts, err)
+
+		select {
+		case <-time.After(retryConfig.delay(attempt, retryAfter)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return lastErr
+}
+
+// attemptContentsOpener returns a function producing the io.ReadCloser to send for each attempt,
+// and a cleanup function to call once sending is done. With maxAttempts <= 1 it just hands back
+// source.Contents unchanged (streamed straight through, as Send always used to). With retries
+// enabled it buffers source.Contents to a bounded temp file first so every attempt can reread it.
+func (c *Client) attemptContentsOpener(source Source, maxAttempts int) (open func() (io.ReadCloser, error), cleanup func(), err error) {
+	if maxAttempts <= 1 {
+		used := false
+		return func() (io.ReadCloser, error) {
+			if used {
+				return nil, fmt.Errorf("archive contents already consumed")
+			}
+			used = true
+			return source.Contents, nil
+		}, func() {}, nil
+	}
+
+	return c.bufferToTempFile(source.Contents)
+}
+
+// bufferToTempFile copies contents (closing it when done), bounded to c.maxBytes, into a temp
+// file and returns a function that reopens that file for reading plus a cleanup that removes it.
+// This is needed anywhere source.Contents must be read more than once — across retry attempts, or
+// once per destination when sending to the gateway and a Sink at the same time — since Contents
+// is a plain io.ReadCloser that can't be rewound.
+func (c *Client) bufferToTempFile(contents io.ReadCloser) (open func() (io.ReadCloser, error), cleanup func(), err error) {
+	tmpFile, err := os.CreateTemp("", "insights-archive-*.tar.gz")
+	if err != nil {
+		return nil, func() {}, err
+	}
+	defer contents.Close()
+	if _, err := io.Copy(tmpFile, io.LimitReader(contents, c.maxBytes)); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return nil, func() {}, fmt.Errorf("unable to buffer archive: %v", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return nil, func() {}, err
+	}
+
+	return func() (io.ReadCloser, error) {
+			return os.Open(tmpFile.Name())
+		}, func() {
+			if err := os.Remove(tmpFile.Name()); err != nil {
+				klog.Warningf("Failed to remove buffered archive %s: %v", tmpFile.Name(), err)
+			}
+		}, nil
+}
+
+// sendToGatewayOnce performs a single POST of source to the gateway and returns the response
+// status code (0 if the request could not be sent at all) and, for a 429 response, the
+// server-requested Retry-After duration.
+func (c *Client) sendToGatewayOnce(
+	ctx context.Context, endpoint string, source Source, cv *configv1.ClusterVersion, idempotencyKey string,
+) (statusCode int, retryAfter time.Duration, err error) {
+	req, err := c.prepareRequest(ctx, http.MethodPost, endpoint, cv)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set(idempotencyKeyHeader, idempotencyKey)
 
 	bytesRead := make(chan int64, 1)
 	pr, pw := io.Pipe()
@@ -227,8 +416,8 @@ func (c *Client) Send(ctx context.Context, endpoint string, source Source) error
 	if err != nil {
 		klog.V(4).Infof("Unable to build a request, possible invalid token: %v", err)
 		// if the request is not build, for example because of invalid endpoint,(maybe some problem with DNS), we want to have record about it in metrics as well.
-		counterRequestSend.WithLabelValues(c.metricsName, "0").Inc()
-		return fmt.Errorf("unable to build request to connect to Insights server: %v", err)
+		counterRequestSend.WithLabelValues(c.metricsName, "0", gatewaySinkName).Inc()
+		return 0, 0, fmt.Errorf("unable to build request to connect to Insights server: %w", err)
 	}
 
 	requestID := resp.Header.Get(insightsReqId)
@@ -242,31 +431,35 @@ func (c *Client) Send(ctx context.Context, endpoint string, source Source) error
 		}
 	}()
 
-	counterRequestSend.WithLabelValues(c.metricsName, strconv.Itoa(resp.StatusCode)).Inc()
+	counterRequestSend.WithLabelValues(c.metricsName, strconv.Itoa(resp.StatusCode), gatewaySinkName).Inc()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
 
 	if resp.StatusCode == http.StatusUnauthorized {
 		klog.V(2).Infof("gateway server %s returned 401, %s=%s", resp.Request.URL, insightsReqId, requestID)
-		return authorizer.Error{Err: fmt.Errorf("your Red Hat account is not enabled for remote support or your token has expired: %s", responseBody(resp))}
+		return resp.StatusCode, retryAfter, authorizer.Error{Err: fmt.Errorf("your Red Hat account is not enabled for remote support or your token has expired: %s", responseBody(resp))}
 	}
 
 	if resp.StatusCode == http.StatusForbidden {
 		klog.V(2).Infof("gateway server %s returned 403, %s=%s", resp.Request.URL, insightsReqId, requestID)
-		return authorizer.Error{Err: fmt.Errorf("your Red Hat account is not enabled for remote support")}
+		return resp.StatusCode, retryAfter, authorizer.Error{Err: fmt.Errorf("your Red Hat account is not enabled for remote support")}
 	}
 
 	if resp.StatusCode == http.StatusBadRequest {
-		return fmt.Errorf("gateway server bad request: %s (request=%s): %s", resp.Request.URL, requestID, responseBody(resp))
+		return resp.StatusCode, retryAfter, fmt.Errorf("gateway server bad request: %s (request=%s): %s", resp.Request.URL, requestID, responseBody(resp))
 	}
 
 	if resp.StatusCode >= 300 || resp.StatusCode < 200 {
-		return fmt.Errorf("gateway server reported unexpected error code: %d (request=%s): %s", resp.StatusCode, requestID, responseBody(resp))
+		return resp.StatusCode, retryAfter, fmt.Errorf("gateway server reported unexpected error code: %d (request=%s): %s", resp.StatusCode, requestID, responseBody(resp))
 	}
 
 	if len(requestID) > 0 {
 		klog.V(2).Infof("Successfully reported id=%s %s=%s, wrote=%d", source.ID, insightsReqId, requestID, <-bytesRead)
 	}
 
-	return nil
+	return resp.StatusCode, 0, nil
 }
 
 // RecvReport perform a request to Insights Results Smart Proxy endpoint
@@ -452,7 +645,7 @@ var (
 	counterRequestSend = metrics.NewCounterVec(&metrics.CounterOpts{
 		Name: "insightsclient_request_send_total",
 		Help: "Tracks the number of metrics sends",
-	}, []string{"client", "status_code"})
+	}, []string{"client", "status_code", "sink"})
 	counterRequestRecvReport = metrics.NewCounterVec(&metrics.CounterOpts{
 		Name: "insightsclient_request_recvreport_total",
 		Help: "Tracks the number of reports requested",
@@ -0,0 +1,111 @@
+package insightsclient
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const idempotencyKeyHeader = "X-RH-Insights-Idempotency-Key"
+
+// RetryConfig controls how Client.Send retries a failed gateway upload. The delay between
+// attempts is exponential backoff with full jitter: a random duration between 0 and
+// min(MaxDelay, BaseDelay*2^(attempt-1)).
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts, including the first; 0 or 1 disables retries.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryConfig returns the backoff policy recommended for Insights archive uploads.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 5,
+		BaseDelay:   2 * time.Second,
+		MaxDelay:    2 * time.Minute,
+	}
+}
+
+// SetRetryConfig installs cfg as the policy used to retry transient Send failures. Leaving it
+// unset (the zero value) keeps Send's original one-shot, streamed-upload behavior.
+func (c *Client) SetRetryConfig(cfg RetryConfig) {
+	c.retryConfig = cfg
+}
+
+func (cfg RetryConfig) orDefault() RetryConfig {
+	if cfg.MaxAttempts < 1 {
+		cfg.MaxAttempts = 1
+	}
+	return cfg
+}
+
+// delay computes the full-jitter exponential backoff before the next attempt, honoring
+// retryAfter (from a 429 response's Retry-After header) when it's longer.
+func (cfg RetryConfig) delay(attempt int, retryAfter time.Duration) time.Duration {
+	backoff := cfg.BaseDelay << (attempt - 1) //nolint:gosec
+	if backoff > cfg.MaxDelay || (backoff <= 0 && cfg.BaseDelay > 0) {
+		backoff = cfg.MaxDelay
+	}
+	jittered := time.Duration(rand.Int63n(int64(backoff) + 1)) //nolint:gosec
+	if retryAfter > jittered {
+		return retryAfter
+	}
+	return jittered
+}
+
+// isRetryableSend reports whether a failed Send attempt is worth retrying: connection errors,
+// 408, 429, and 5xx other than 501 (Not Implemented, which retrying can't fix).
+func isRetryableSend(statusCode int, err error) bool {
+	if statusCode == 0 {
+		var netErr net.Error
+		return errors.As(err, &netErr)
+	}
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	case http.StatusNotImplemented:
+		return false
+	default:
+		return statusCode >= 500 && statusCode < 600
+	}
+}
+
+// parseRetryAfter parses an HTTP Retry-After header value expressed as a number of seconds. An
+// HTTP-date form or an unparsable/empty value yields 0 (fall back to computed backoff).
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+var (
+	histogramRequestSendAttempt = metrics.NewHistogramVec(&metrics.HistogramOpts{
+		Name:    "insightsclient_request_send_attempt_seconds",
+		Help:    "Tracks the time it took to complete each individual attempt of an archive send, successful or not",
+		Buckets: metrics.DefBuckets,
+	}, []string{"client", "status_code", "attempt"})
+
+	counterRequestSendRetriesExhausted = metrics.NewCounterVec(&metrics.CounterOpts{
+		Name: "insightsclient_request_send_retries_exhausted_total",
+		Help: "Tracks the number of archive sends that failed every retry attempt",
+	}, []string{"client"})
+)
+
+func init() {
+	if err := legacyregistry.Register(histogramRequestSendAttempt); err != nil {
+		fmt.Println(err)
+	}
+	if err := legacyregistry.Register(counterRequestSendRetriesExhausted); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,74 @@
+package insightsclient
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableSend(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{name: "net error with zero status code is retryable", statusCode: 0, err: &net.DNSError{IsTimeout: true}, want: true},
+		{name: "wrapped net error is retryable", statusCode: 0, err: fmt.Errorf("dial failed: %w", &net.DNSError{}), want: true},
+		{name: "non-net error with zero status code is not retryable", statusCode: 0, err: errors.New("boom"), want: false},
+		{name: "408 is retryable", statusCode: http.StatusRequestTimeout, want: true},
+		{name: "429 is retryable", statusCode: http.StatusTooManyRequests, want: true},
+		{name: "501 is not retryable", statusCode: http.StatusNotImplemented, want: false},
+		{name: "500 is retryable", statusCode: http.StatusInternalServerError, want: true},
+		{name: "599 is retryable", statusCode: 599, want: true},
+		{name: "404 is not retryable", statusCode: http.StatusNotFound, want: false},
+		{name: "200 is not retryable", statusCode: http.StatusOK, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableSend(tt.statusCode, tt.err); got != tt.want {
+				t.Errorf("isRetryableSend(%d, %v) = %v, want %v", tt.statusCode, tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryConfigDelay(t *testing.T) {
+	cfg := RetryConfig{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	t.Run("retryAfter longer than backoff wins", func(t *testing.T) {
+		if got := cfg.delay(1, time.Minute); got != time.Minute {
+			t.Errorf("delay() = %v, want %v", got, time.Minute)
+		}
+	})
+
+	t.Run("jittered backoff never exceeds MaxDelay", func(t *testing.T) {
+		for attempt := 1; attempt <= 10; attempt++ {
+			got := cfg.delay(attempt, 0)
+			if got < 0 || got > cfg.MaxDelay {
+				t.Fatalf("delay(%d, 0) = %v, want in [0, %v]", attempt, got, cfg.MaxDelay)
+			}
+		}
+	})
+
+	t.Run("jittered backoff never exceeds BaseDelay*2^(attempt-1) before the MaxDelay cap", func(t *testing.T) {
+		uncapped := RetryConfig{BaseDelay: time.Millisecond, MaxDelay: time.Hour}
+		got := uncapped.delay(3, 0)
+		max := uncapped.BaseDelay << 2
+		if got < 0 || got > max {
+			t.Fatalf("delay(3, 0) = %v, want in [0, %v]", got, max)
+		}
+	})
+}
+
+func TestRetryConfigOrDefault(t *testing.T) {
+	if got := (RetryConfig{}).orDefault().MaxAttempts; got != 1 {
+		t.Errorf("zero-value MaxAttempts.orDefault() = %d, want 1", got)
+	}
+	if got := (RetryConfig{MaxAttempts: 5}).orDefault().MaxAttempts; got != 5 {
+		t.Errorf("MaxAttempts: 5 .orDefault() = %d, want 5", got)
+	}
+}
@@ -0,0 +1,155 @@
+package insightsclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"k8s.io/klog/v2"
+)
+
+// S3CredentialsSource selects how an S3Sink authenticates against the object store.
+type S3CredentialsSource string
+
+const (
+	// S3CredentialsStatic uses the static AccessKeyID/SecretAccessKey pair from S3SinkConfig.
+	S3CredentialsStatic S3CredentialsSource = "static"
+	// S3CredentialsFile reads a shared credentials file (S3SinkConfig.CredentialsFile) from disk.
+	S3CredentialsFile S3CredentialsSource = "file"
+	// S3CredentialsIRSA uses a projected service account token exchanged for temporary credentials
+	// via AWS STS AssumeRoleWithWebIdentity, the mechanism backing IAM Roles for Service Accounts.
+	S3CredentialsIRSA S3CredentialsSource = "irsa"
+)
+
+// S3SinkConfig configures an S3Sink.
+type S3SinkConfig struct {
+	// Endpoint is the S3-compatible endpoint URL. Empty selects the AWS default for Region.
+	Endpoint string
+	// Bucket is the bucket archives are uploaded to.
+	Bucket string
+	// Region is the bucket's region.
+	Region string
+	// Prefix is prepended to every object key, followed by a "/", e.g. "insights-archives".
+	Prefix string
+
+	CredentialsSource S3CredentialsSource
+	// AccessKeyID and SecretAccessKey are used when CredentialsSource is S3CredentialsStatic.
+	AccessKeyID     string
+	SecretAccessKey string
+	// CredentialsFile is the shared credentials file path (loaded via
+	// config.WithSharedCredentialsFiles) used when CredentialsSource is S3CredentialsFile.
+	CredentialsFile string
+	// RoleARN and WebIdentityTokenFile are used when CredentialsSource is S3CredentialsIRSA; they
+	// mirror the AWS_ROLE_ARN/AWS_WEB_IDENTITY_TOKEN_FILE conventions for projected tokens.
+	RoleARN              string
+	WebIdentityTokenFile string
+}
+
+// S3Sink uploads archives to an S3-compatible object store instead of (or in addition to) the Red
+// Hat Ingress gateway, for disconnected/air-gapped clusters that archive to an on-prem store.
+type S3Sink struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Sink builds an S3Sink from cfg.
+func NewS3Sink(ctx context.Context, cfg S3SinkConfig) (*S3Sink, error) {
+	loadOpts, err := s3ConfigLoadOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load S3 sink configuration: %v", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+		o.UsePathStyle = true
+	})
+
+	return &S3Sink{
+		client: client,
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+// s3ConfigLoadOptions builds the config.LoadDefaultConfig options for cfg.CredentialsSource. The
+// shared-credentials-file source is handled via config.WithSharedCredentialsFiles rather than a
+// standalone credentials.AWS.CredentialsProvider, because the SDK doesn't expose one: loading a
+// credentials file is part of the same config-resolution chain as everything else.
+func s3ConfigLoadOptions(cfg S3SinkConfig) ([]func(*config.LoadOptions) error, error) {
+	opts := []func(*config.LoadOptions) error{config.WithRegion(cfg.Region)}
+
+	switch cfg.CredentialsSource {
+	case S3CredentialsStatic:
+		opts = append(opts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		))
+	case S3CredentialsIRSA:
+		stsClient := sts.New(sts.Options{Region: cfg.Region})
+		opts = append(opts, config.WithCredentialsProvider(
+			stscreds.NewWebIdentityRoleProvider(stsClient, cfg.RoleARN, stscreds.IdentityTokenFile(cfg.WebIdentityTokenFile)),
+		))
+	case S3CredentialsFile:
+		if cfg.CredentialsFile == "" {
+			return nil, fmt.Errorf("S3 sink: credentialsFile must be set when credentialsSource is %q", S3CredentialsFile)
+		}
+		opts = append(opts, config.WithSharedCredentialsFiles([]string{cfg.CredentialsFile}))
+	default:
+		return nil, fmt.Errorf("S3 sink: unknown credentials source %q", cfg.CredentialsSource)
+	}
+
+	return opts, nil
+}
+
+// Upload implements Sink by PUTting source's contents and a metadata object (mirroring the
+// custom_metadata.gathering_time field createAndWriteMIMEHeader sends to the gateway) to the
+// configured bucket, both under s.prefix/source.ID. The returned request ID is the archive's
+// object key, since the upload has no other identifier here.
+func (s *S3Sink) Upload(ctx context.Context, source Source) (string, error) {
+	archiveKey := s.objectKey(source.ID, "payload.tar.gz")
+	if _, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(archiveKey),
+		Body:        source.Contents,
+		ContentType: aws.String(source.Type),
+	}); err != nil {
+		return "", fmt.Errorf("unable to upload archive to S3 sink: %v", err)
+	}
+
+	metadata := fmt.Sprintf(`{"custom_metadata":{"gathering_time":%q}}`, source.CreationTime.Format(time.RFC3339))
+	metadataKey := s.objectKey(source.ID, "metadata.json")
+	if _, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(metadataKey),
+		Body:        io.NopCloser(strings.NewReader(metadata)),
+		ContentType: aws.String("application/json"),
+	}); err != nil {
+		return "", fmt.Errorf("unable to upload metadata to S3 sink: %v", err)
+	}
+
+	klog.V(2).Infof("Uploaded %s to S3 bucket %s as %s", source.ID, s.bucket, archiveKey)
+	return archiveKey, nil
+}
+
+func (s *S3Sink) objectKey(sourceID, name string) string {
+	if s.prefix == "" {
+		return fmt.Sprintf("%s/%s", sourceID, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", s.prefix, sourceID, name)
+}
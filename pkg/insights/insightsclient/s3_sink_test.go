@@ -0,0 +1,71 @@
+package insightsclient
+
+import "testing"
+
+func TestS3ConfigLoadOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     S3SinkConfig
+		wantErr bool
+	}{
+		{
+			name: "static credentials",
+			cfg:  S3SinkConfig{Region: "us-east-1", CredentialsSource: S3CredentialsStatic, AccessKeyID: "id", SecretAccessKey: "secret"},
+		},
+		{
+			name: "irsa credentials",
+			cfg:  S3SinkConfig{Region: "us-east-1", CredentialsSource: S3CredentialsIRSA, RoleARN: "arn:aws:iam::123456789012:role/insights", WebIdentityTokenFile: "/var/run/secrets/token"},
+		},
+		{
+			name: "file credentials",
+			cfg:  S3SinkConfig{Region: "us-east-1", CredentialsSource: S3CredentialsFile, CredentialsFile: "/etc/insights/aws-credentials"},
+		},
+		{
+			name:    "file credentials without a path is an error",
+			cfg:     S3SinkConfig{Region: "us-east-1", CredentialsSource: S3CredentialsFile},
+			wantErr: true,
+		},
+		{
+			name:    "unknown credentials source is an error",
+			cfg:     S3SinkConfig{Region: "us-east-1", CredentialsSource: "bogus"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := s3ConfigLoadOptions(tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(opts) != 2 {
+				t.Fatalf("expected a region option plus a credentials option, got %d", len(opts))
+			}
+		})
+	}
+}
+
+func TestS3SinkObjectKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		sink   *S3Sink
+		source string
+		object string
+		want   string
+	}{
+		{name: "no prefix", sink: &S3Sink{}, source: "abc-123", object: "payload.tar.gz", want: "abc-123/payload.tar.gz"},
+		{name: "with prefix", sink: &S3Sink{prefix: "insights-archives"}, source: "abc-123", object: "metadata.json", want: "insights-archives/abc-123/metadata.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sink.objectKey(tt.source, tt.object); got != tt.want {
+				t.Errorf("objectKey(%q, %q) = %q, want %q", tt.source, tt.object, got, tt.want)
+			}
+		})
+	}
+}
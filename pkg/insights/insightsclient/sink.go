@@ -0,0 +1,90 @@
+package insightsclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// LimitedReadCloser caps reads at N bytes, the same maxBytes limit the gateway upload path
+// enforces via LimitedReader, so a Sink can't be handed an unbounded archive.
+type LimitedReadCloser struct {
+	R io.ReadCloser
+	N int64
+}
+
+func (l *LimitedReadCloser) Read(p []byte) (int, error) {
+	if l.N <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > l.N {
+		p = p[:l.N]
+	}
+	n, err := l.R.Read(p)
+	l.N -= int64(n)
+	return n, err
+}
+
+func (l *LimitedReadCloser) Close() error {
+	return l.R.Close()
+}
+
+// Sink is the destination an archive gathered by the operator is uploaded to. The default Sink is
+// the Red Hat Ingress gateway (the behaviour of Client.Send when no Sink is configured); SetSink
+// lets disconnected/air-gapped clusters redirect (or add) archival to an on-prem object store
+// instead.
+type Sink interface {
+	// Upload uploads source and returns the upstream request ID, if the sink has one.
+	Upload(ctx context.Context, source Source) (requestID string, err error)
+}
+
+// SinkType selects which Sink implementation a SinkConfig builds.
+type SinkType string
+
+const (
+	// SinkTypeGateway uploads to the Red Hat Ingress gateway via Client.Send. It is the default
+	// and requires no SinkConfig at all.
+	SinkTypeGateway SinkType = "gateway"
+	// SinkTypeS3 uploads to an S3-compatible object store.
+	SinkTypeS3 SinkType = "s3"
+)
+
+// SetSink overrides the destination used by Send. name is recorded in the "sink" label of the
+// insightsclient_request_send_total metric, so pick something stable like "gateway" or "s3".
+// When alsoSendToGateway is true, Send uploads to both sink and the gateway instead of sink alone.
+func (c *Client) SetSink(name string, sink Sink, alsoSendToGateway bool) {
+	c.sinkName = name
+	c.sink = sink
+	c.sinkAlsoSendToGateway = alsoSendToGateway
+}
+
+// SinkConfig is the operator-config-level description of which Sink to use. It lives alongside
+// the existing maxBytes setting so disconnected/air-gapped clusters can archive gatherings to an
+// on-prem object store instead of, or in addition to, console.redhat.com.
+type SinkConfig struct {
+	Type SinkType
+	S3   S3SinkConfig
+	// AlsoSendToGateway, when Type is not SinkTypeGateway, keeps sending to the Ingress gateway in
+	// addition to the configured sink, instead of replacing it.
+	AlsoSendToGateway bool
+}
+
+// ApplySink builds the Sink described by cfg and installs it on c via SetSink. A zero-value (or
+// SinkTypeGateway) cfg clears any previously configured sink, so Send reverts to uploading to the
+// gateway even if a sink was applied by an earlier call.
+func (c *Client) ApplySink(ctx context.Context, cfg SinkConfig) error {
+	switch cfg.Type {
+	case "", SinkTypeGateway:
+		c.SetSink("", nil, false)
+		return nil
+	case SinkTypeS3:
+		sink, err := NewS3Sink(ctx, cfg.S3)
+		if err != nil {
+			return err
+		}
+		c.SetSink(string(SinkTypeS3), sink, cfg.AlsoSendToGateway)
+		return nil
+	default:
+		return fmt.Errorf("unknown insights sink type %q", cfg.Type)
+	}
+}